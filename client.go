@@ -0,0 +1,161 @@
+package gocd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// Client is a GoCD API client. It holds only connection details; all
+// resource operations live on the per-resource services returned by
+// Pipelines, PipelineConfigs, Environments, and System. Each service reads
+// and writes its resource's ETag explicitly (as a return value and an
+// argument, respectively) rather than caching it on the Client, so that two
+// goroutines calling different services at the same time can't clobber each
+// other's ETag.
+type Client struct {
+	host       string
+	login      string
+	password   string
+	httpClient *http.Client
+	timeout    time.Duration
+	retry      retryPolicy
+}
+
+// Option configures a Client at construction time.
+type Option func(*Client)
+
+// WithDefaultTimeout sets the timeout applied to every call that does not
+// override it with a per-call CallOption. A zero value (the default) means
+// calls run without a client-imposed deadline.
+func WithDefaultTimeout(d time.Duration) Option {
+	return func(c *Client) { c.timeout = d }
+}
+
+func New(host, login, password string, opts ...Option) *Client {
+	c := &Client{host: host, login: login, password: password, httpClient: http.DefaultClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// NewWithClient is like New but lets the caller supply the *http.Client used
+// for every request, e.g. one configured with TLS pinning, a corporate
+// proxy, mTLS, or an instrumented http.RoundTripper.
+func NewWithClient(host, login, password string, hc *http.Client, opts ...Option) *Client {
+	c := &Client{host: host, login: login, password: password, httpClient: hc}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// HTTPClient returns the *http.Client used to make requests.
+func (c *Client) HTTPClient() *http.Client {
+	return c.httpClient
+}
+
+// Pipelines returns the service for pipeline instance, history, and
+// lifecycle (pause/unpause/schedule) operations.
+func (c *Client) Pipelines() *PipelinesService {
+	return &PipelinesService{client: c}
+}
+
+// PipelineConfigs returns the service for reading and writing pipeline
+// configurations.
+func (c *Client) PipelineConfigs() *PipelineConfigsService {
+	return &PipelineConfigsService{client: c}
+}
+
+// Environments returns the service for reading and writing environments.
+func (c *Client) Environments() *EnvironmentsService {
+	return &EnvironmentsService{client: c}
+}
+
+// System returns the service for server-level operations such as Version.
+func (c *Client) System() *SystemService {
+	return &SystemService{client: c}
+}
+
+// callOptions holds the per-call settings controlled by CallOption.
+type callOptions struct {
+	timeout       time.Duration
+	retryOverride *bool
+}
+
+// CallOption overrides Client-level defaults for a single call.
+type CallOption func(*callOptions)
+
+// WithTimeout bounds a single call, overriding the Client's default timeout
+// set via WithDefaultTimeout. Pass 0 to run the call without a deadline.
+func WithTimeout(d time.Duration) CallOption {
+	return func(o *callOptions) { o.timeout = d }
+}
+
+// WithRetryEnabled overrides whether this call is retried, ignoring the
+// verb-based default (GET/PUT/DELETE retry automatically, other verbs do
+// not). SchedulePipeline is a POST and may not be idempotent on the GoCD
+// side, so it is opted out by default; pass WithRetryEnabled(true) to a
+// specific call if the caller knows it is safe to retry.
+func WithRetryEnabled(enabled bool) CallOption {
+	return func(o *callOptions) { o.retryOverride = &enabled }
+}
+
+func (c *Client) resolveCallOptions(opts ...CallOption) callOptions {
+	cfg := callOptions{timeout: c.timeout}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+// withDeadline derives a context that is cancelled after the effective
+// timeout elapses, surfacing as context.DeadlineExceeded so callers can tell
+// a timeout apart from an explicit cancellation. context.WithTimeout already
+// stops its internal timer when its CancelFunc is called early, so callers
+// must still defer the returned cancel func to avoid leaking it.
+func (c *Client) withDeadline(ctx context.Context, cfg callOptions) (context.Context, func()) {
+	if cfg.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, cfg.timeout)
+}
+
+func (c *Client) unmarshal(data io.ReadCloser, v interface{}) error {
+	defer data.Close()
+	if body, err := ioutil.ReadAll(data); err != nil {
+		return err
+	} else {
+		return json.Unmarshal(body, v)
+	}
+}
+
+// do issues a GoCD API request, applying the call's effective deadline and
+// retry policy. It is the single entry point services use instead of
+// calling goCDRequest directly.
+func (c *Client) do(ctx context.Context, method, resource string, body []byte, headers map[string]string, opts ...CallOption) (*http.Response, error) {
+	cfg := c.resolveCallOptions(opts...)
+
+	ctx, cancel := c.withDeadline(ctx, cfg)
+	defer cancel()
+
+	return c.doWithRetry(ctx, method, resource, body, headers, cfg)
+}
+
+func (c *Client) goCDRequest(ctx context.Context, method string, resource string, body []byte, headers map[string]string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, resource, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.SetBasicAuth(c.login, c.password)
+	return c.httpClient.Do(req)
+}
@@ -0,0 +1,148 @@
+package gocd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EnvironmentsService provides access to environments. See Client for why
+// reads return an ETag and writes take one as an argument.
+type EnvironmentsService struct {
+	client *Client
+}
+
+func (s *EnvironmentsService) GetAll() (*Environments, string, error) {
+	return s.GetAllContext(context.Background())
+}
+
+func (s *EnvironmentsService) GetAllContext(ctx context.Context, opts ...CallOption) (*Environments, string, error) {
+	resp, err := s.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/admin/environments", s.client.host),
+		[]byte{},
+		map[string]string{"Accept": "application/vnd.go.cd.v1+json"}, opts...)
+	if err != nil {
+		return nil, "", err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, "", s.client.createError(resp)
+	}
+
+	envs := Environments{}
+	if err := s.client.unmarshal(resp.Body, &envs); err != nil {
+		return nil, "", err
+	}
+	return &envs, resp.Header.Get("ETag"), nil
+}
+
+func (s *EnvironmentsService) Get(name string) (*Environment, string, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+func (s *EnvironmentsService) GetContext(ctx context.Context, name string, opts ...CallOption) (*Environment, string, error) {
+	resp, err := s.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/admin/environments/%s", s.client.host, name),
+		[]byte{},
+		map[string]string{"Accept": "application/vnd.go.cd.v1+json"}, opts...)
+	if err != nil {
+		return nil, "", err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, "", s.client.createError(resp)
+	}
+
+	env := Environment{}
+	if err := s.client.unmarshal(resp.Body, &env); err != nil {
+		return nil, "", err
+	}
+	return &env, resp.Header.Get("ETag"), nil
+}
+
+func (s *EnvironmentsService) New(env *Environment) error {
+	return s.NewContext(context.Background(), env)
+}
+
+func (s *EnvironmentsService) NewContext(ctx context.Context, env *Environment, opts ...CallOption) error {
+	data := struct {
+		Name                 string                `json:"name"`
+		Pipelines            []map[string]string   `json:"pipelines"`
+		Agents               []map[string]string   `json:"agents"`
+		EnvironmentVariables []EnvironmentVariable `json:"environment_variables"`
+	}{Name: env.Name, EnvironmentVariables: env.EnvironmentVariables}
+
+	for _, p := range env.Pipelines {
+		data.Pipelines = append(data.Pipelines, map[string]string{"name": p.Name})
+	}
+	for _, a := range env.Agents {
+		data.Agents = append(data.Agents, map[string]string{"uuid": a.Uuid})
+	}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if resp, err := s.client.do(ctx, "POST",
+		fmt.Sprintf("%s/go/api/admin/environments", s.client.host),
+		body,
+		map[string]string{"Accept": "application/vnd.go.cd.v1+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+func (s *EnvironmentsService) Set(env *Environment, etag string) error {
+	return s.SetContext(context.Background(), env, etag)
+}
+
+func (s *EnvironmentsService) SetContext(ctx context.Context, env *Environment, etag string, opts ...CallOption) error {
+	data := struct {
+		Name                 string                `json:"name"`
+		Pipelines            []map[string]string   `json:","`
+		Agents               []map[string]string   `json:","`
+		EnvironmentVariables []EnvironmentVariable `json:"environment_variables"`
+	}{Name: env.Name}
+
+	for _, p := range env.Pipelines {
+		data.Pipelines = append(data.Pipelines, map[string]string{"name": p.Name})
+	}
+	for _, a := range env.Agents {
+		data.Agents = append(data.Agents, map[string]string{"uuid": a.Uuid})
+	}
+	data.EnvironmentVariables = env.EnvironmentVariables
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	if resp, err := s.client.do(ctx, "PUT",
+		fmt.Sprintf("%s/go/api/admin/environments/%s", s.client.host, env.Name),
+		body,
+		map[string]string{
+			"If-Match": etag,
+			"Accept":   "application/vnd.go.cd.v1+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+func (s *EnvironmentsService) Delete(env *Environment, etag string) error {
+	return s.DeleteContext(context.Background(), env, etag)
+}
+
+func (s *EnvironmentsService) DeleteContext(ctx context.Context, env *Environment, etag string, opts ...CallOption) error {
+	if resp, err := s.client.do(ctx, "DELETE",
+		fmt.Sprintf("%s/go/api/admin/environments/%s", s.client.host, env.Name),
+		[]byte{},
+		map[string]string{"If-Match": etag,
+			"Accept": "application/vnd.go.cd.v1+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package gocd
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnvironmentsService_GetAll(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("method = %q, want GET", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/environments" {
+			t.Errorf("path = %q, want /go/api/admin/environments", r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v1+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v1+json", got)
+		}
+		w.Header().Set("ETag", `"envs-etag"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"_embedded":{"environments":[{"name":"dev"}]}}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	envs, etag, err := client.Environments().GetAll()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(envs.Embeded.Environments) != 1 || envs.Embeded.Environments[0].Name != "dev" {
+		t.Errorf("envs.Embeded.Environments = %+v, want one environment named dev", envs.Embeded.Environments)
+	}
+	if etag != `"envs-etag"` {
+		t.Errorf("etag = %q, want \"envs-etag\"", etag)
+	}
+}
+
+func TestEnvironmentsService_New(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method = %q, want POST", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/environments" {
+			t.Errorf("path = %q, want /go/api/admin/environments", r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v1+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v1+json", got)
+		}
+
+		var got struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if got.Name != "dev" {
+			t.Errorf("body.Name = %q, want dev", got.Name)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.Environments().New(&Environment{Name: "dev"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnvironmentsService_Get(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("method = %q, want GET", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/environments/dev" {
+			t.Errorf("path = %q, want /go/api/admin/environments/dev", r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v1+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v1+json", got)
+		}
+		w.Header().Set("ETag", `"env-etag"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"dev"}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	env, etag, err := client.Environments().Get("dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if env.Name != "dev" {
+		t.Errorf("env.Name = %q, want dev", env.Name)
+	}
+	if etag != `"env-etag"` {
+		t.Errorf("etag = %q, want \"env-etag\"", etag)
+	}
+}
+
+func TestEnvironmentsService_Set(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("method = %q, want PUT", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/environments/dev" {
+			t.Errorf("path = %q, want /go/api/admin/environments/dev", r.URL.Path)
+		}
+		if got := r.Header.Get("If-Match"); got != `"env-etag"` {
+			t.Errorf("If-Match = %q, want \"env-etag\"", got)
+		}
+
+		var got struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if got.Name != "dev" {
+			t.Errorf("body.Name = %q, want dev", got.Name)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.Environments().Set(&Environment{Name: "dev"}, `"env-etag"`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestEnvironmentsService_Delete(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" {
+			t.Errorf("method = %q, want DELETE", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/environments/dev" {
+			t.Errorf("path = %q, want /go/api/admin/environments/dev", r.URL.Path)
+		}
+		if got := r.Header.Get("If-Match"); got != `"env-etag"` {
+			t.Errorf("If-Match = %q, want \"env-etag\"", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.Environments().Delete(&Environment{Name: "dev"}, `"env-etag"`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package gocd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// APIError is returned for any GoCD API call whose response status is not
+// the one that call expects (200 OK for most calls, 202 Accepted for
+// Schedule). It carries enough detail for callers to branch on the failure
+// (stale ETag, missing pipeline, validation error) instead of parsing error
+// strings.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response, e.g. 404, 409, 422.
+	StatusCode int
+	// Status is the HTTP status line, e.g. "404 Not Found".
+	Status string
+	// Method and URL identify the request that failed.
+	Method string
+	URL    string
+	// Message is the human-readable error GoCD returned in its JSON error
+	// envelope (the "message" field), or the raw response body when GoCD
+	// did not respond with JSON.
+	Message string
+	// Details holds the "data" field of GoCD's JSON error envelope, when
+	// present. It is nil for non-JSON error responses.
+	Details map[string]interface{}
+}
+
+// goCDErrorEnvelope mirrors the JSON body GoCD returns on API errors, e.g.
+// {"message":"...","data":{...}}.
+type goCDErrorEnvelope struct {
+	Message string                 `json:"message"`
+	Data    map[string]interface{} `json:"data"`
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("gocd: %s %s: %s", e.Method, e.URL, e.Status)
+	}
+	return fmt.Sprintf("gocd: %s %s: %s: %s", e.Method, e.URL, e.Status, e.Message)
+}
+
+// IsNotFound reports whether the API call failed because the resource does
+// not exist (HTTP 404).
+func (e *APIError) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
+
+// IsConflict reports whether the API call failed because the ETag supplied
+// with the request was stale (HTTP 409).
+func (e *APIError) IsConflict() bool {
+	return e.StatusCode == http.StatusConflict
+}
+
+// IsValidation reports whether the API call failed because GoCD rejected the
+// request body (HTTP 422).
+func (e *APIError) IsValidation() bool {
+	return e.StatusCode == http.StatusUnprocessableEntity
+}
+
+// IsAPIError reports whether err is (or wraps) an *APIError, and returns it.
+// It is a thin convenience wrapper around errors.As.
+func IsAPIError(err error) (*APIError, bool) {
+	var apiErr *APIError
+	ok := errors.As(err, &apiErr)
+	return apiErr, ok
+}
+
+func (p *Client) createError(resp *http.Response) error {
+	defer resp.Body.Close()
+
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Method:     resp.Request.Method,
+		URL:        resp.Request.URL.String(),
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return apiErr
+	}
+
+	if strings.Contains(resp.Header.Get("Content-Type"), "json") {
+		var envelope goCDErrorEnvelope
+		if err := json.Unmarshal(body, &envelope); err == nil {
+			apiErr.Message = envelope.Message
+			apiErr.Details = envelope.Data
+			return apiErr
+		}
+	}
+
+	apiErr.Message = string(body)
+	return apiErr
+}
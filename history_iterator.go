@@ -0,0 +1,135 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// defaultHistoryPageSize matches the page size GoCD itself falls back to
+// when a pipeline history request omits page_size.
+const defaultHistoryPageSize = 10
+
+// HistoryOption configures a PipelineHistoryIterator.
+type HistoryOption func(*PipelineHistoryIterator)
+
+// WithPageSize sets how many instances the iterator requests per page.
+func WithPageSize(n int) HistoryOption {
+	return func(it *PipelineHistoryIterator) { it.pageSize = n }
+}
+
+// WithSince stops iteration once an instance with counter <= since is
+// reached, so callers can walk only the history newer than a known point.
+func WithSince(counter int) HistoryOption {
+	return func(it *PipelineHistoryIterator) { it.since = counter }
+}
+
+// WithFilter skips instances for which f returns false.
+func WithFilter(f func(*PipelineInstance) bool) HistoryOption {
+	return func(it *PipelineHistoryIterator) { it.filter = f }
+}
+
+// PipelineHistoryIterator walks a pipeline's history a page at a time,
+// yielding one instance per Next call regardless of the underlying page
+// boundaries. It is not safe for concurrent use.
+type PipelineHistoryIterator struct {
+	client   *Client
+	name     string
+	pageSize int
+	since    int
+	filter   func(*PipelineInstance) bool
+	callOpts []CallOption
+
+	buffer  []PipelineInstance
+	idx     int
+	offset  int
+	current PipelineInstance
+	done    bool
+	err     error
+}
+
+// HistoryIterator returns an iterator over name's pipeline history, newest
+// page fetched first and each page's instances yielded in the order GoCD
+// returns them, so the most recent instance comes first.
+func (s *PipelinesService) HistoryIterator(name string, opts ...HistoryOption) *PipelineHistoryIterator {
+	it := &PipelineHistoryIterator{client: s.client, name: name, pageSize: defaultHistoryPageSize}
+	for _, opt := range opts {
+		opt(it)
+	}
+	return it
+}
+
+// Next advances the iterator, fetching another page from the server as the
+// current one is exhausted. It returns false once history is exhausted, the
+// since bound is reached, or an error occurs; check Err to distinguish the
+// two.
+func (it *PipelineHistoryIterator) Next(ctx context.Context) bool {
+	if it.err != nil || it.done {
+		return false
+	}
+
+	for {
+		if it.idx < len(it.buffer) {
+			inst := it.buffer[it.idx]
+			it.idx++
+
+			if it.since > 0 && inst.Counter <= it.since {
+				it.done = true
+				return false
+			}
+			if it.filter != nil && !it.filter(&inst) {
+				continue
+			}
+
+			it.current = inst
+			return true
+		}
+
+		if it.offset > 0 && len(it.buffer) < it.pageSize {
+			it.done = true
+			return false
+		}
+
+		page, err := it.fetchPage(ctx)
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if len(page.Pipelines) == 0 {
+			it.done = true
+			return false
+		}
+
+		it.buffer = page.Pipelines
+		it.idx = 0
+		it.offset += len(page.Pipelines)
+	}
+}
+
+// Value returns the instance produced by the most recent call to Next.
+func (it *PipelineHistoryIterator) Value() *PipelineInstance {
+	return &it.current
+}
+
+// Err returns the first error encountered while fetching pages, if any.
+func (it *PipelineHistoryIterator) Err() error {
+	return it.err
+}
+
+func (it *PipelineHistoryIterator) fetchPage(ctx context.Context) (*PipelineInstances, error) {
+	resp, err := it.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/pipelines/%s/history?offset=%d&page_size=%d", it.client.host, it.name, it.offset, it.pageSize),
+		[]byte{},
+		map[string]string{}, it.callOpts...)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, it.client.createError(resp)
+	}
+
+	page := PipelineInstances{}
+	if err := it.client.unmarshal(resp.Body, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
@@ -0,0 +1,142 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPipelineHistoryIteratorWalksAllPages(t *testing.T) {
+	pages := [][]int{{5, 4}, {3, 2}, {1}}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset := r.URL.Query().Get("offset")
+		var page []int
+		switch offset {
+		case "0":
+			page = pages[0]
+		case "2":
+			page = pages[1]
+		case "4":
+			page = pages[2]
+		default:
+			page = nil
+		}
+
+		body := `{"pipelines":[`
+		for i, counter := range page {
+			if i > 0 {
+				body += ","
+			}
+			body += fmt.Sprintf(`{"counter":%d}`, counter)
+		}
+		body += `]}`
+
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	it := client.Pipelines().HistoryIterator("up42", WithPageSize(2))
+
+	var counters []int
+	for it.Next(context.Background()) {
+		counters = append(counters, it.Value().Counter)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{5, 4, 3, 2, 1}
+	if len(counters) != len(want) {
+		t.Fatalf("counters = %v, want %v", counters, want)
+	}
+	for i := range want {
+		if counters[i] != want[i] {
+			t.Errorf("counters[%d] = %d, want %d", i, counters[i], want[i])
+		}
+	}
+}
+
+func TestPipelineHistoryIteratorStopsAtSince(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"pipelines":[{"counter":5},{"counter":4},{"counter":3}]}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	it := client.Pipelines().HistoryIterator("up42", WithSince(4))
+
+	var counters []int
+	for it.Next(context.Background()) {
+		counters = append(counters, it.Value().Counter)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{5}
+	if len(counters) != len(want) || counters[0] != want[0] {
+		t.Errorf("counters = %v, want %v", counters, want)
+	}
+}
+
+func TestPipelineHistoryIteratorAppliesFilter(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"pipelines":[{"counter":5},{"counter":4},{"counter":3},{"counter":2}]}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	it := client.Pipelines().HistoryIterator("up42", WithFilter(func(pi *PipelineInstance) bool {
+		return pi.Counter%2 == 0
+	}))
+
+	var counters []int
+	for it.Next(context.Background()) {
+		counters = append(counters, it.Value().Counter)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []int{4, 2}
+	if len(counters) != len(want) {
+		t.Fatalf("counters = %v, want %v", counters, want)
+	}
+	for i := range want {
+		if counters[i] != want[i] {
+			t.Errorf("counters[%d] = %d, want %d", i, counters[i], want[i])
+		}
+	}
+}
+
+func TestPipelinesService_GetHistoryFetchesSinglePage(t *testing.T) {
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/go/api/pipelines/up42/history" {
+			t.Errorf("path = %q, want /go/api/pipelines/up42/history", r.URL.Path)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"pipelines":[{"counter":5},{"counter":4}]}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	page, err := client.Pipelines().GetHistory("up42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(page.Pipelines) != 2 {
+		t.Errorf("len(page.Pipelines) = %d, want 2", len(page.Pipelines))
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+}
@@ -0,0 +1,116 @@
+package gocd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// PipelineConfigsService provides access to pipeline configurations. See
+// Client for why reads return an ETag and writes take one as an argument.
+type PipelineConfigsService struct {
+	client *Client
+}
+
+func (s *PipelineConfigsService) Get(name string) (*PipelineConfig, string, error) {
+	return s.GetContext(context.Background(), name)
+}
+
+func (s *PipelineConfigsService) GetContext(ctx context.Context, name string, opts ...CallOption) (*PipelineConfig, string, error) {
+	resp, err := s.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/admin/pipelines/%s", s.client.host, name),
+		[]byte{},
+		map[string]string{"Accept": "application/vnd.go.cd.v2+json"}, opts...)
+	if err != nil {
+		return nil, "", err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, "", s.client.createError(resp)
+	}
+
+	pipeline := PipelineConfig{}
+	if err := s.client.unmarshal(resp.Body, &pipeline); err != nil {
+		return nil, "", err
+	}
+	return &pipeline, resp.Header.Get("ETag"), nil
+}
+
+func (s *PipelineConfigsService) New(pipeline *PipelineConfig, group string) error {
+	return s.NewContext(context.Background(), pipeline, group)
+}
+
+func (s *PipelineConfigsService) NewContext(ctx context.Context, pipeline *PipelineConfig, group string, opts ...CallOption) error {
+	data := struct {
+		Group    string         `json:"group"`
+		Pipeline PipelineConfig `json:"pipeline"`
+	}{Group: group, Pipeline: *pipeline}
+
+	body, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if resp, err := s.client.do(ctx, "POST",
+		fmt.Sprintf("%s/go/api/admin/pipelines", s.client.host),
+		body,
+		map[string]string{"Accept": "application/vnd.go.cd.v2+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+func (s *PipelineConfigsService) Set(pipeline *PipelineConfig, etag string) error {
+	return s.SetContext(context.Background(), pipeline, etag)
+}
+
+func (s *PipelineConfigsService) SetContext(ctx context.Context, pipeline *PipelineConfig, etag string, opts ...CallOption) error {
+	body, err := json.Marshal(pipeline)
+	if err != nil {
+		return err
+	}
+	if resp, err := s.client.do(ctx, "PUT",
+		fmt.Sprintf("%s/go/api/admin/pipelines/%s", s.client.host, pipeline.Name),
+		body,
+		map[string]string{"If-Match": etag,
+			"Accept": "application/vnd.go.cd.v2+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+func (s *PipelineConfigsService) Delete(pipeline *PipelineConfig) error {
+	return s.DeleteContext(context.Background(), pipeline)
+}
+
+// DeleteContext deletes the pipeline config and then removes it from
+// whichever environment references it. All HTTP calls share ctx, so a
+// deadline set by the caller (or a CallOption here) bounds the whole
+// operation instead of just the first request.
+func (s *PipelineConfigsService) DeleteContext(ctx context.Context, pipeline *PipelineConfig, opts ...CallOption) error {
+	ctx, cancel := s.client.withDeadline(ctx, s.client.resolveCallOptions(opts...))
+	defer cancel()
+
+	if resp, err := s.client.do(ctx, "DELETE",
+		fmt.Sprintf("%s/go/api/admin/pipelines/%s", s.client.host, pipeline.Name),
+		[]byte{},
+		map[string]string{"Accept": "application/vnd.go.cd.v2+json"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+
+	envs, etag, err := s.client.Environments().GetAllContext(ctx, opts...)
+	if err != nil {
+		return err
+	}
+
+	for _, env := range envs.Embeded.Environments {
+		if env.DeletePipeline(pipeline.Name) {
+			return s.client.Environments().SetContext(ctx, &env, etag, opts...)
+		}
+	}
+	return nil
+}
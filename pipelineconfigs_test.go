@@ -0,0 +1,169 @@
+package gocd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestPipelineConfigsService_Get(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("method = %q, want GET", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/pipelines/up42" {
+			t.Errorf("path = %q, want /go/api/admin/pipelines/up42", r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v2+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v2+json", got)
+		}
+		w.Header().Set("ETag", `"abc123"`)
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"name":"up42"}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	pipeline, etag, err := client.PipelineConfigs().Get("up42")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if pipeline.Name != "up42" {
+		t.Errorf("pipeline.Name = %q, want up42", pipeline.Name)
+	}
+	if etag != `"abc123"` {
+		t.Errorf("etag = %q, want \"abc123\"", etag)
+	}
+}
+
+func TestPipelineConfigsService_Set(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "PUT" {
+			t.Errorf("method = %q, want PUT", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/pipelines/up42" {
+			t.Errorf("path = %q, want /go/api/admin/pipelines/up42", r.URL.Path)
+		}
+		if got := r.Header.Get("If-Match"); got != `"abc123"` {
+			t.Errorf("If-Match = %q, want \"abc123\"", got)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v2+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v2+json", got)
+		}
+
+		var got PipelineConfig
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if got.Name != "up42" {
+			t.Errorf("body.Name = %q, want up42", got.Name)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.PipelineConfigs().Set(&PipelineConfig{Name: "up42"}, `"abc123"`); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPipelineConfigsService_New(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" {
+			t.Errorf("method = %q, want POST", r.Method)
+		}
+		if r.URL.Path != "/go/api/admin/pipelines" {
+			t.Errorf("path = %q, want /go/api/admin/pipelines", r.URL.Path)
+		}
+
+		var got struct {
+			Group    string         `json:"group"`
+			Pipeline PipelineConfig `json:"pipeline"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+			t.Fatalf("decode body: %v", err)
+		}
+		if got.Group != "defaultGroup" {
+			t.Errorf("body.Group = %q, want defaultGroup", got.Group)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.PipelineConfigs().New(&PipelineConfig{Name: "up42"}, "defaultGroup"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestPipelineConfigsService_Delete(t *testing.T) {
+	var deleteCalled, environmentsFetched, environmentSet bool
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "DELETE" && r.URL.Path == "/go/api/admin/pipelines/up42":
+			deleteCalled = true
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "GET" && r.URL.Path == "/go/api/admin/environments":
+			environmentsFetched = true
+			w.Header().Set("ETag", `"env-etag"`)
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"_embedded":{"environments":[{"name":"dev","pipelines":[{"name":"up42"}]}]}}`))
+		case r.Method == "PUT" && r.URL.Path == "/go/api/admin/environments/dev":
+			environmentSet = true
+			if got := r.Header.Get("If-Match"); got != `"env-etag"` {
+				t.Errorf("If-Match = %q, want \"env-etag\"", got)
+			}
+			w.WriteHeader(http.StatusOK)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if err := client.PipelineConfigs().Delete(&PipelineConfig{Name: "up42"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !deleteCalled || !environmentsFetched || !environmentSet {
+		t.Errorf("delete=%v environments=%v set=%v, want all true", deleteCalled, environmentsFetched, environmentSet)
+	}
+}
+
+func TestPipelineConfigsService_DeleteContextTimeoutBoundsChainedCalls(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "DELETE" && r.URL.Path == "/go/api/admin/pipelines/up42":
+			w.WriteHeader(http.StatusOK)
+		case r.Method == "GET" && r.URL.Path == "/go/api/admin/environments":
+			// Simulate the GetEnvironments call hanging, the exact failure
+			// mode this request was filed to bound with a timeout.
+			<-r.Context().Done()
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+
+	start := time.Now()
+	err := client.PipelineConfigs().DeleteContext(context.Background(), &PipelineConfig{Name: "up42"}, WithTimeout(20*time.Millisecond))
+	elapsed := time.Since(start)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > time.Second {
+		t.Errorf("elapsed = %v, want the WithTimeout passed to DeleteContext to cut off the hang in GetEnvironments", elapsed)
+	}
+}
@@ -0,0 +1,99 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// PipelinesService provides access to pipeline instances, history, and
+// lifecycle operations (pause, unpause, schedule).
+type PipelinesService struct {
+	client *Client
+}
+
+func (s *PipelinesService) GetInstance(name string, inst int) (*PipelineInstance, error) {
+	return s.GetInstanceContext(context.Background(), name, inst)
+}
+
+func (s *PipelinesService) GetInstanceContext(ctx context.Context, name string, inst int, opts ...CallOption) (*PipelineInstance, error) {
+	resp, err := s.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/pipelines/%s/instance/%d", s.client.host, name, inst),
+		[]byte{},
+		map[string]string{}, opts...)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, s.client.createError(resp)
+	}
+
+	pipeline := PipelineInstance{}
+	if err := s.client.unmarshal(resp.Body, &pipeline); err != nil {
+		return nil, err
+	}
+	return &pipeline, nil
+}
+
+// GetHistory returns a single page of name's pipeline history. To walk the
+// full history across pages, use HistoryIterator instead.
+func (s *PipelinesService) GetHistory(name string) (*PipelineInstances, error) {
+	return s.GetHistoryContext(context.Background(), name)
+}
+
+func (s *PipelinesService) GetHistoryContext(ctx context.Context, name string, opts ...CallOption) (*PipelineInstances, error) {
+	it := s.HistoryIterator(name)
+	it.callOpts = opts
+	return it.fetchPage(ctx)
+}
+
+func (s *PipelinesService) Unpause(name string) error {
+	return s.UnpauseContext(context.Background(), name)
+}
+
+func (s *PipelinesService) UnpauseContext(ctx context.Context, name string, opts ...CallOption) error {
+	if resp, err := s.client.do(ctx, "POST",
+		fmt.Sprintf("%s/go/api/pipelines/%s/unpause", s.client.host, name),
+		[]byte{},
+		map[string]string{"Confirm": "true"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+func (s *PipelinesService) Pause(name string) error {
+	return s.PauseContext(context.Background(), name)
+}
+
+func (s *PipelinesService) PauseContext(ctx context.Context, name string, opts ...CallOption) error {
+	if resp, err := s.client.do(ctx, "POST",
+		fmt.Sprintf("%s/go/api/pipelines/%s/pause", s.client.host, name),
+		[]byte{'p', 'a', 'u', 's', 'e', 'C', 'a', 'u', 's', 'e', '=', 't', 'a', 'k', 'e', ' ', 's', 'o', 'm', 'e', ' ', 'r', 'e', 's', 't'},
+		map[string]string{"Confirm": "true"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusOK {
+		return s.client.createError(resp)
+	}
+	return nil
+}
+
+// Schedule triggers a pipeline run. Unlike the other Pipelines methods this
+// is excluded from automatic retries by default, since a POST to the
+// schedule endpoint may not be safe to repeat; pass WithRetryEnabled(true)
+// to ScheduleContext if the caller knows otherwise.
+func (s *PipelinesService) Schedule(name string, data []byte) error {
+	return s.ScheduleContext(context.Background(), name, data)
+}
+
+func (s *PipelinesService) ScheduleContext(ctx context.Context, name string, data []byte, opts ...CallOption) error {
+	if resp, err := s.client.do(ctx, "POST",
+		fmt.Sprintf("%s/go/api/pipelines/%s/schedule", s.client.host, name),
+		data,
+		map[string]string{"Confirm": "true"}, opts...); err != nil {
+		return err
+	} else if resp.StatusCode != http.StatusAccepted {
+		return s.client.createError(resp)
+	}
+	return nil
+}
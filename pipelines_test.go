@@ -0,0 +1,109 @@
+package gocd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestPipelinesService(t *testing.T) {
+	tests := []struct {
+		name       string
+		call       func(s *PipelinesService) error
+		method     string
+		path       string
+		headers    map[string]string
+		respBody   string
+		wantBody   string
+		statusCode int
+	}{
+		{
+			name: "GetInstance",
+			call: func(s *PipelinesService) error {
+				_, err := s.GetInstance("up42", 3)
+				return err
+			},
+			method:     "GET",
+			path:       "/go/api/pipelines/up42/instance/3",
+			statusCode: http.StatusOK,
+			respBody:   "{}",
+		},
+		{
+			name: "GetHistory",
+			call: func(s *PipelinesService) error {
+				_, err := s.GetHistory("up42")
+				return err
+			},
+			method:     "GET",
+			path:       "/go/api/pipelines/up42/history",
+			statusCode: http.StatusOK,
+			respBody:   "{}",
+		},
+		{
+			name:       "Pause",
+			call:       func(s *PipelinesService) error { return s.Pause("up42") },
+			method:     "POST",
+			path:       "/go/api/pipelines/up42/pause",
+			headers:    map[string]string{"Confirm": "true"},
+			wantBody:   "pauseCause=take some rest",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Unpause",
+			call:       func(s *PipelinesService) error { return s.Unpause("up42") },
+			method:     "POST",
+			path:       "/go/api/pipelines/up42/unpause",
+			headers:    map[string]string{"Confirm": "true"},
+			statusCode: http.StatusOK,
+		},
+		{
+			name:       "Schedule",
+			call:       func(s *PipelinesService) error { return s.Schedule("up42", []byte(`variables[FOO]=bar`)) },
+			method:     "POST",
+			path:       "/go/api/pipelines/up42/schedule",
+			headers:    map[string]string{"Confirm": "true"},
+			wantBody:   "variables[FOO]=bar",
+			statusCode: http.StatusAccepted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMethod, gotPath, gotBody string
+			var gotHeader http.Header
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotMethod = r.Method
+				gotPath = r.URL.Path
+				gotHeader = r.Header
+				body := make([]byte, r.ContentLength)
+				r.Body.Read(body)
+				gotBody = string(body)
+
+				w.WriteHeader(tt.statusCode)
+				w.Write([]byte(tt.respBody))
+			}))
+			defer srv.Close()
+
+			client := New(srv.URL, "user", "pass")
+			if err := tt.call(client.Pipelines()); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if gotMethod != tt.method {
+				t.Errorf("method = %q, want %q", gotMethod, tt.method)
+			}
+			if gotPath != tt.path {
+				t.Errorf("path = %q, want %q", gotPath, tt.path)
+			}
+			for k, v := range tt.headers {
+				if got := gotHeader.Get(k); got != v {
+					t.Errorf("header %s = %q, want %q", k, got, v)
+				}
+			}
+			if tt.wantBody != "" && gotBody != tt.wantBody {
+				t.Errorf("body = %q, want %q", gotBody, tt.wantBody)
+			}
+		})
+	}
+}
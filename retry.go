@@ -0,0 +1,102 @@
+package gocd
+
+import (
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryPolicy configures automatic retries for idempotent requests. A zero
+// value disables retries.
+type retryPolicy struct {
+	maxAttempts int
+	base        time.Duration
+	max         time.Duration
+}
+
+// WithRetry enables automatic retries for idempotent requests (GET, PUT,
+// DELETE) that fail with a 5xx or 429 response. maxAttempts is the total
+// number of attempts, including the first; base and max bound the
+// exponential backoff applied between attempts, which is jittered and
+// honors any Retry-After header GoCD sends under load.
+func WithRetry(maxAttempts int, base, max time.Duration) Option {
+	return func(c *Client) {
+		c.retry = retryPolicy{maxAttempts: maxAttempts, base: base, max: max}
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetryResponse(resp *http.Response) bool {
+	return resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+}
+
+// retryDelay computes how long to wait before the next attempt, preferring
+// the server's Retry-After header when present and otherwise falling back
+// to exponential backoff with full jitter.
+func retryDelay(policy retryPolicy, attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if d, ok := parseRetryAfter(resp); ok {
+			return d
+		}
+	}
+
+	backoff := policy.base << attempt
+	if backoff <= 0 || backoff > policy.max {
+		backoff = policy.max
+	}
+	return time.Duration(rand.Int63n(int64(backoff) + 1))
+}
+
+func parseRetryAfter(resp *http.Response) (time.Duration, bool) {
+	v := resp.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if when, err := http.ParseTime(v); err == nil {
+		return time.Until(when), true
+	}
+	return 0, false
+}
+
+func (c *Client) doWithRetry(ctx context.Context, method, resource string, body []byte, headers map[string]string, cfg callOptions) (*http.Response, error) {
+	enabled := isIdempotentMethod(method)
+	if cfg.retryOverride != nil {
+		enabled = *cfg.retryOverride
+	}
+	if c.retry.maxAttempts <= 1 {
+		enabled = false
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		resp, err = c.goCDRequest(ctx, method, resource, body, headers)
+		if err != nil || !enabled || attempt >= c.retry.maxAttempts-1 || !shouldRetryResponse(resp) {
+			return resp, err
+		}
+
+		wait := retryDelay(c.retry, attempt, resp)
+		ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package gocd
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientRetriesIdempotentRequestsOn5xx(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"version":"20.1.0"}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass", WithRetry(3, time.Millisecond, 5*time.Millisecond))
+	if _, err := client.System().Version(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 3 {
+		t.Errorf("attempts = %d, want 3", attempts)
+	}
+}
+
+func TestClientHonorsRetryAfterHeader(t *testing.T) {
+	var attempts int
+	var waited time.Duration
+	var last time.Time
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if !last.IsZero() {
+			waited = time.Since(last)
+		}
+		last = time.Now()
+
+		if attempts < 2 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"version":"20.1.0"}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass", WithRetry(3, time.Second, 5*time.Second))
+	if _, err := client.System().Version(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+	if waited > time.Second {
+		t.Errorf("waited = %v, want well under the 1s backoff base (Retry-After should have won)", waited)
+	}
+}
+
+func TestClientDoesNotRetrySchedulePipelineByDefault(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass", WithRetry(3, time.Millisecond, 5*time.Millisecond))
+	if err := client.Pipelines().Schedule("up42", nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if attempts != 1 {
+		t.Errorf("attempts = %d, want 1 (POST should not retry by default)", attempts)
+	}
+}
+
+func TestClientRetriesSchedulePipelineWhenOptedIn(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts < 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass", WithRetry(3, time.Millisecond, 5*time.Millisecond))
+	err := client.Pipelines().ScheduleContext(context.Background(), "up42", nil, WithRetryEnabled(true))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2", attempts)
+	}
+}
@@ -0,0 +1,34 @@
+package gocd
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SystemService provides access to server-level operations.
+type SystemService struct {
+	client *Client
+}
+
+func (s *SystemService) Version() (*Version, error) {
+	return s.VersionContext(context.Background())
+}
+
+func (s *SystemService) VersionContext(ctx context.Context, opts ...CallOption) (*Version, error) {
+	resp, err := s.client.do(ctx, "GET",
+		fmt.Sprintf("%s/go/api/version", s.client.host),
+		[]byte{},
+		map[string]string{"Accept": "application/vnd.go.cd.v1+json"}, opts...)
+	if err != nil {
+		return nil, err
+	} else if resp.StatusCode != http.StatusOK {
+		return nil, s.client.createError(resp)
+	}
+
+	version := Version{}
+	if err := s.client.unmarshal(resp.Body, &version); err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
@@ -0,0 +1,29 @@
+package gocd
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSystemService_Version(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			t.Errorf("method = %q, want GET", r.Method)
+		}
+		if r.URL.Path != "/go/api/version" {
+			t.Errorf("path = %q, want /go/api/version", r.URL.Path)
+		}
+		if got := r.Header.Get("Accept"); got != "application/vnd.go.cd.v1+json" {
+			t.Errorf("Accept = %q, want application/vnd.go.cd.v1+json", got)
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"version":"20.1.0"}`))
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass")
+	if _, err := client.System().Version(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
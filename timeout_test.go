@@ -0,0 +1,58 @@
+package gocd
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestClientDefaultTimeoutCancelsHungRequest(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	client := New(srv.URL, "user", "pass", WithDefaultTimeout(20*time.Millisecond))
+
+	start := time.Now()
+	_, err := client.System().VersionContext(context.Background())
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error from a hung request, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > time.Second {
+		t.Errorf("elapsed = %v, want the call to return promptly once the timeout fires", elapsed)
+	}
+}
+
+func TestClientPerCallTimeoutOverridesDefault(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-r.Context().Done()
+	}))
+	defer srv.Close()
+
+	// The client default is long enough to never fire in this test; the
+	// per-call WithTimeout is what must cut the request short.
+	client := New(srv.URL, "user", "pass", WithDefaultTimeout(time.Hour))
+
+	start := time.Now()
+	_, err := client.System().VersionContext(context.Background(), WithTimeout(20*time.Millisecond))
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected an error from a hung request, got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if elapsed > time.Second {
+		t.Errorf("elapsed = %v, want the per-call timeout to override the hour-long default", elapsed)
+	}
+}